@@ -0,0 +1,32 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+// Config holds the provider-level configuration needed to build a Fastly
+// API client.
+type Config struct {
+	ApiKey string
+}
+
+// FastlyClient wraps the go-fastly client so it can be passed around as the
+// provider's Meta value.
+type FastlyClient struct {
+	conn *gofastly.Client
+}
+
+// Client builds a FastlyClient from the provider configuration.
+func (c *Config) Client() (*FastlyClient, error) {
+	client, err := gofastly.NewClient(c.ApiKey)
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error initializing Fastly client: %s", err)
+	}
+
+	log.Printf("[INFO] Fastly Client configured")
+
+	return &FastlyClient{conn: client}, nil
+}
@@ -0,0 +1,89 @@
+package fastly
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+// LogEndpoint is the shared contract every log streaming destination
+// (syslog, Papertrail, Sumologic, FTP, HTTPS, Kafka, ...) implements so that
+// resourceServiceV1 can drive them generically instead of hand-rolling a
+// Create/Read/Update/Delete cycle per backend the way gcslogging does.
+type LogEndpoint interface {
+	// Name is the top-level schema key for this endpoint, e.g. "syslog".
+	Name() string
+
+	// Schema describes the set element for this endpoint's block.
+	Schema() *schema.Resource
+
+	// List fetches the endpoints Fastly currently has configured for this
+	// service version.
+	List(conn *gofastly.Client, serviceID string, version int) (interface{}, error)
+
+	// Flatten converts the value List returned into the map shape Terraform
+	// state expects. Kept separate from List so it can be unit tested
+	// against fixtures the way flattenGCS already is.
+	Flatten(remote interface{}) []map[string]interface{}
+
+	// Diff reconciles the old and new sets for this endpoint's block. Like
+	// the rest of this provider's set-backed blocks, a changed entry is
+	// deleted and recreated rather than updated in place.
+	Diff(conn *gofastly.Client, serviceID string, version int, old, new *schema.Set) error
+}
+
+// logEndpoints is the registry of pluggable log destinations. Each backend
+// appends itself from its own init(), so adding a new destination never
+// requires touching resource_fastly_service_v1.go.
+var logEndpoints []LogEndpoint
+
+func registerLogEndpoint(e LogEndpoint) {
+	logEndpoints = append(logEndpoints, e)
+}
+
+// envDefault builds a schema.SchemaDefaultFunc for secret-bearing fields
+// (API tokens, passwords, shared keys) following the pattern GCS logging
+// established with FASTLY_GCS_EMAIL/FASTLY_GCS_SECRET_KEY: fall back to an
+// environment variable when the attribute is left unset in config.
+func envDefault(envVar string) schema.SchemaDefaultFunc {
+	return schema.EnvDefaultFunc(envVar, "")
+}
+
+// processLogEndpoints runs Diff for every registered backend whose block
+// changed in this apply.
+func processLogEndpoints(d *schema.ResourceData, conn *gofastly.Client, version int) error {
+	for _, e := range logEndpoints {
+		if !d.HasChange(e.Name()) {
+			continue
+		}
+
+		o, n := d.GetChange(e.Name())
+		if o == nil {
+			o = new(schema.Set)
+		}
+		if n == nil {
+			n = new(schema.Set)
+		}
+
+		if err := e.Diff(conn, d.Id(), version, o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readLogEndpoints populates state for every registered backend.
+func readLogEndpoints(d *schema.ResourceData, conn *gofastly.Client, version int) error {
+	for _, e := range logEndpoints {
+		remote, err := e.List(conn, d.Id(), version)
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set(e.Name(), e.Flatten(remote)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
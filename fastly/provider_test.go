@@ -0,0 +1,111 @@
+package fastly
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+var testAccProviders map[string]terraform.ResourceProvider
+var testAccProvider *schema.Provider
+
+func init() {
+	testAccProvider = Provider().(*schema.Provider)
+	testAccProviders = map[string]terraform.ResourceProvider{
+		"fastly": testAccProvider,
+	}
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	if v := os.Getenv("FASTLY_API_KEY"); v == "" {
+		t.Fatal("FASTLY_API_KEY must be set for acceptance tests")
+	}
+}
+
+func testAccCheckServiceV1Exists(n string, service *gofastly.ServiceDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		latest, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+			ID: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		*service = *latest
+
+		return nil
+	}
+}
+
+// testAccCheckSetElemAttr checks attr on the single element of a TypeSet
+// block. Set elements are keyed in state by their hash code rather than by
+// position, so the key is matched as "<set>.<hash>.<attr>".
+func testAccCheckSetElemAttr(n, set, attr, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		var found []string
+		for k, v := range rs.Primary.Attributes {
+			parts := strings.Split(k, ".")
+			if len(parts) == 3 && parts[0] == set && parts[1] != "#" && parts[2] == attr {
+				found = append(found, v)
+			}
+		}
+
+		if len(found) != 1 {
+			return fmt.Errorf("%s: expected one %s element with %s, got %d", n, set, attr, len(found))
+		}
+		if found[0] != value {
+			return fmt.Errorf("%s: %s.*.%s expected %q, got %q", n, set, attr, value, found[0])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServiceV1Destroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fastly_service_v1" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		l, err := conn.ListServices(&gofastly.ListServicesInput{})
+		if err != nil {
+			return fmt.Errorf("[WARN] Error listing services when deleting Fastly Service (%s): %s", rs.Primary.ID, err)
+		}
+
+		for _, s := range l {
+			if s.ID == rs.Primary.ID {
+				return fmt.Errorf("[WARN] Tried deleting Service (%s), but was still found", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,852 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func resourceServiceV1() *schema.Resource {
+	r := &schema.Resource{
+		Create: resourceServiceV1Create,
+		Read:   resourceServiceV1Read,
+		Update: resourceServiceV1Update,
+		Delete: resourceServiceV1Delete,
+
+		CustomizeDiff: resourceServiceV1CustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name for this Service",
+			},
+
+			"active_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"domain": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"backend": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  80,
+						},
+					},
+				},
+			},
+
+			"condition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								var found bool
+								for _, t := range []string{"REQUEST", "RESPONSE", "CACHE"} {
+									if v.(string) == t {
+										found = true
+									}
+								}
+								if !found {
+									errors = append(errors, fmt.Errorf("Bad value for condition type (%s): must be one of REQUEST, RESPONSE, CACHE", v.(string)))
+								}
+								return
+							},
+						},
+						"statement": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  10,
+						},
+					},
+				},
+			},
+
+			"vcl": vclSchema(),
+
+			"cache_setting": cacheSettingSchema(),
+
+			"response_object": responseObjectSchema(),
+
+			"gcslogging": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Unique name to refer to this logging setup",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc("FASTLY_GCS_EMAIL", ""),
+							Description: "The email address associated with the target GCS bucket on your account",
+						},
+						"bucket_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the bucket in which to store the logs",
+						},
+						"secret_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							DefaultFunc: schema.EnvDefaultFunc("FASTLY_GCS_SECRET_KEY", ""),
+							Description: "The secret key associated with the target gcs bucket on your account",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path to store the files. Must end with a trailing slash",
+						},
+						"period": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     3600,
+							Description: "How frequently the logs should be transferred, in seconds",
+						},
+						"gzip_level": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "Gzip Compression level",
+						},
+						"format": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "%h %l %u %t %r %>s",
+							Description: "Apache style log formatting",
+						},
+						"format_version": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "The version of the custom logging format used. Can be either 1 or 2",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(int)
+								if value != 1 && value != 2 {
+									errors = append(errors, fmt.Errorf("%q must be either 1 or 2", k))
+								}
+								return
+							},
+						},
+						"message_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "classic",
+							Description: "How the message should be formatted. One of: classic, loggly, logplex, blank",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								var found bool
+								for _, t := range []string{"classic", "loggly", "logplex", "blank"} {
+									if v.(string) == t {
+										found = true
+									}
+								}
+								if !found {
+									errors = append(errors, fmt.Errorf("Bad value for message_type (%s): must be one of classic, loggly, logplex, blank", v.(string)))
+								}
+								return
+							},
+						},
+						"timestamp_format": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "%Y-%m-%dT%H:%M:%S.000",
+							Description: "The strftime specified timestamp formatting",
+						},
+						"response_condition": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of a condition to apply this logging",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, e := range logEndpoints {
+		r.Schema[e.Name()] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     e.Schema(),
+		}
+	}
+
+	return r
+}
+
+func resourceServiceV1Create(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	service, err := conn.CreateService(&gofastly.CreateServiceInput{
+		Name: d.Get("name").(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(service.ID)
+
+	return resourceServiceV1Update(d, meta)
+}
+
+func resourceServiceV1Update(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	service, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+		ID: d.Id(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var latestVersion int
+	if service.ActiveVersion.Number != 0 {
+		// The active version is locked against edits by the API, so clone it
+		// into a new, editable version before touching any block below.
+		clone, err := conn.CloneVersion(&gofastly.CloneVersionInput{
+			Service: d.Id(),
+			Version: service.ActiveVersion.Number,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error cloning version (%d): %s", service.ActiveVersion.Number, err)
+		}
+		latestVersion = clone.Number
+	} else {
+		newVersion, err := conn.CreateVersion(&gofastly.CreateVersionInput{
+			Service: d.Id(),
+		})
+		if err != nil {
+			return err
+		}
+		latestVersion = newVersion.Number
+	}
+
+	if d.HasChange("domain") {
+		if err := processDomains(d, conn, latestVersion); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("backend") {
+		if err := processBackends(d, conn, latestVersion); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("condition") {
+		if err := processConditions(d, conn, latestVersion); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("gcslogging") {
+		if err := processGCS(d, conn, latestVersion); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("vcl") {
+		if err := processVCLs(d, conn, latestVersion); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("cache_setting") {
+		if err := processCacheSettings(d, conn, latestVersion); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("response_object") {
+		if err := processResponseObjects(d, conn, latestVersion); err != nil {
+			return err
+		}
+	}
+
+	if err := processLogEndpoints(d, conn, latestVersion); err != nil {
+		return err
+	}
+
+	if _, err := conn.ActivateVersion(&gofastly.ActivateVersionInput{
+		Service: d.Id(),
+		Version: latestVersion,
+	}); err != nil {
+		return fmt.Errorf("[ERR] Error activating version (%d): %s", latestVersion, err)
+	}
+
+	return resourceServiceV1Read(d, meta)
+}
+
+func processDomains(d *schema.ResourceData, conn *gofastly.Client, version int) error {
+	os, ns := d.GetChange("domain")
+	if os == nil {
+		os = new(schema.Set)
+	}
+	if ns == nil {
+		ns = new(schema.Set)
+	}
+
+	oss := os.(*schema.Set)
+	nss := ns.(*schema.Set)
+	remove := oss.Difference(nss).List()
+	add := nss.Difference(oss).List()
+
+	for _, dRaw := range remove {
+		df := dRaw.(map[string]interface{})
+		if err := conn.DeleteDomain(&gofastly.DeleteDomainInput{
+			Service: d.Id(),
+			Version: version,
+			Name:    df["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, dRaw := range add {
+		df := dRaw.(map[string]interface{})
+		if _, err := conn.CreateDomain(&gofastly.CreateDomainInput{
+			Service: d.Id(),
+			Version: version,
+			Name:    df["name"].(string),
+			Comment: df["comment"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func processBackends(d *schema.ResourceData, conn *gofastly.Client, version int) error {
+	ob, nb := d.GetChange("backend")
+	if ob == nil {
+		ob = new(schema.Set)
+	}
+	if nb == nil {
+		nb = new(schema.Set)
+	}
+
+	obs := ob.(*schema.Set)
+	nbs := nb.(*schema.Set)
+	remove := obs.Difference(nbs).List()
+	add := nbs.Difference(obs).List()
+
+	for _, bRaw := range remove {
+		bf := bRaw.(map[string]interface{})
+		if err := conn.DeleteBackend(&gofastly.DeleteBackendInput{
+			Service: d.Id(),
+			Version: version,
+			Name:    bf["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, bRaw := range add {
+		bf := bRaw.(map[string]interface{})
+		if _, err := conn.CreateBackend(&gofastly.CreateBackendInput{
+			Service: d.Id(),
+			Version: version,
+			Name:    bf["name"].(string),
+			Address: bf["address"].(string),
+			Port:    uint(bf["port"].(int)),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func processConditions(d *schema.ResourceData, conn *gofastly.Client, version int) error {
+	oc, nc := d.GetChange("condition")
+	if oc == nil {
+		oc = new(schema.Set)
+	}
+	if nc == nil {
+		nc = new(schema.Set)
+	}
+
+	ocs := oc.(*schema.Set)
+	ncs := nc.(*schema.Set)
+	remove := ocs.Difference(ncs).List()
+	add := ncs.Difference(ocs).List()
+
+	for _, cRaw := range remove {
+		cf := cRaw.(map[string]interface{})
+		if err := conn.DeleteCondition(&gofastly.DeleteConditionInput{
+			Service: d.Id(),
+			Version: version,
+			Name:    cf["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, cRaw := range add {
+		cf := cRaw.(map[string]interface{})
+		if _, err := conn.CreateCondition(&gofastly.CreateConditionInput{
+			Service:   d.Id(),
+			Version:   version,
+			Name:      cf["name"].(string),
+			Type:      cf["type"].(string),
+			Statement: cf["statement"].(string),
+			Priority:  cf["priority"].(int),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func processGCS(d *schema.ResourceData, conn *gofastly.Client, version int) error {
+	og, ng := d.GetChange("gcslogging")
+	if og == nil {
+		og = new(schema.Set)
+	}
+	if ng == nil {
+		ng = new(schema.Set)
+	}
+
+	ogs := og.(*schema.Set)
+	ngs := ng.(*schema.Set)
+	remove := ogs.Difference(ngs).List()
+	add := ngs.Difference(ogs).List()
+
+	for _, gRaw := range remove {
+		gf := gRaw.(map[string]interface{})
+		if err := conn.DeleteGCS(&gofastly.DeleteGCSInput{
+			Service: d.Id(),
+			Version: version,
+			Name:    gf["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, gRaw := range add {
+		gf := gRaw.(map[string]interface{})
+
+		if err := validateConditionReference(d, gf["response_condition"].(string), "RESPONSE"); err != nil {
+			return err
+		}
+
+		opts := gofastly.CreateGCSInput{
+			Service:           d.Id(),
+			Version:           version,
+			Name:              gf["name"].(string),
+			User:              gf["email"].(string),
+			Bucket:            gf["bucket_name"].(string),
+			SecretKey:         gf["secret_key"].(string),
+			Path:              gf["path"].(string),
+			Period:            uint(gf["period"].(int)),
+			GzipLevel:         uint(gf["gzip_level"].(int)),
+			Format:            gf["format"].(string),
+			FormatVersion:     uint(gf["format_version"].(int)),
+			MessageType:       gf["message_type"].(string),
+			TimestampFormat:   gf["timestamp_format"].(string),
+			ResponseCondition: gf["response_condition"].(string),
+		}
+
+		log.Printf("[DEBUG] Create GCS Opts: %#v", opts)
+		if _, err := conn.CreateGCS(&opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceServiceV1Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	service, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+		ID: d.Id(),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", service.Name)
+	d.Set("active_version", service.ActiveVersion.Number)
+
+	if service.ActiveVersion.Number == 0 {
+		return nil
+	}
+
+	domainList, err := conn.ListDomains(&gofastly.ListDomainsInput{
+		Service: d.Id(),
+		Version: service.ActiveVersion.Number,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Domains for (%s), version (%v): %s", d.Id(), service.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("domain", flattenDomains(domainList)); err != nil {
+		log.Printf("[WARN] Error setting domain for (%s): %s", d.Id(), err)
+	}
+
+	backendList, err := conn.ListBackends(&gofastly.ListBackendsInput{
+		Service: d.Id(),
+		Version: service.ActiveVersion.Number,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Backends for (%s), version (%v): %s", d.Id(), service.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("backend", flattenBackends(backendList)); err != nil {
+		log.Printf("[WARN] Error setting backend for (%s): %s", d.Id(), err)
+	}
+
+	conditionList, err := conn.ListConditions(&gofastly.ListConditionsInput{
+		Service: d.Id(),
+		Version: service.ActiveVersion.Number,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Conditions for (%s), version (%v): %s", d.Id(), service.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("condition", flattenConditions(conditionList)); err != nil {
+		log.Printf("[WARN] Error setting condition for (%s): %s", d.Id(), err)
+	}
+
+	gcsList, err := conn.ListGCSs(&gofastly.ListGCSsInput{
+		Service: d.Id(),
+		Version: service.ActiveVersion.Number,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up GCS for (%s), version (%v): %s", d.Id(), service.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("gcslogging", flattenGCS(gcsList)); err != nil {
+		log.Printf("[WARN] Error setting gcslogging for (%s): %s", d.Id(), err)
+	}
+
+	if err := readLogEndpoints(d, conn, service.ActiveVersion.Number); err != nil {
+		return err
+	}
+
+	vclList, err := conn.ListVCLs(&gofastly.ListVCLsInput{
+		Service: d.Id(),
+		Version: service.ActiveVersion.Number,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up VCLs for (%s), version (%v): %s", d.Id(), service.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("vcl", flattenVCLs(vclList)); err != nil {
+		log.Printf("[WARN] Error setting vcl for (%s): %s", d.Id(), err)
+	}
+
+	csList, err := conn.ListCacheSettings(&gofastly.ListCacheSettingsInput{
+		Service: d.Id(),
+		Version: service.ActiveVersion.Number,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Cache Settings for (%s), version (%v): %s", d.Id(), service.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("cache_setting", flattenCacheSettings(csList)); err != nil {
+		log.Printf("[WARN] Error setting cache_setting for (%s): %s", d.Id(), err)
+	}
+
+	roList, err := conn.ListResponseObjects(&gofastly.ListResponseObjectsInput{
+		Service: d.Id(),
+		Version: service.ActiveVersion.Number,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Response Objects for (%s), version (%v): %s", d.Id(), service.ActiveVersion.Number, err)
+	}
+
+	if err := d.Set("response_object", flattenResponseObjects(roList)); err != nil {
+		log.Printf("[WARN] Error setting response_object for (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceServiceV1Delete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	if !d.Get("force_destroy").(bool) {
+		return nil
+	}
+
+	service, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+		ID: d.Id(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if service.ActiveVersion.Number != 0 {
+		if _, err := conn.DeactivateVersion(&gofastly.DeactivateVersionInput{
+			Service: d.Id(),
+			Version: service.ActiveVersion.Number,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := conn.DeleteService(&gofastly.DeleteServiceInput{
+		ID: d.Id(),
+	}); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func flattenDomains(domainList []*gofastly.Domain) []map[string]interface{} {
+	var dl []map[string]interface{}
+	for _, d := range domainList {
+		dl = append(dl, map[string]interface{}{
+			"name":    d.Name,
+			"comment": d.Comment,
+		})
+	}
+
+	return dl
+}
+
+func flattenBackends(backendList []*gofastly.Backend) []map[string]interface{} {
+	var bl []map[string]interface{}
+	for _, b := range backendList {
+		bl = append(bl, map[string]interface{}{
+			"name":    b.Name,
+			"address": b.Address,
+			"port":    int(b.Port),
+		})
+	}
+
+	return bl
+}
+
+func flattenConditions(conditionList []*gofastly.Condition) []map[string]interface{} {
+	var cl []map[string]interface{}
+	for _, c := range conditionList {
+		cl = append(cl, map[string]interface{}{
+			"name":      c.Name,
+			"type":      c.Type,
+			"statement": c.Statement,
+			"priority":  int(c.Priority),
+		})
+	}
+
+	return cl
+}
+
+func flattenGCS(gcsList []*gofastly.GCS) []map[string]interface{} {
+	var gl []map[string]interface{}
+	for _, g := range gcsList {
+		gg := map[string]interface{}{
+			"name":        g.Name,
+			"email":       g.User,
+			"bucket_name": g.Bucket,
+			"secret_key":  g.SecretKey,
+			"path":        g.Path,
+			"period":      int(g.Period),
+			"gzip_level":  int(g.GzipLevel),
+			"format":      g.Format,
+		}
+
+		// Only surface these when the API actually returned a value, so
+		// flattening an older-style GCS response (or one without the newer
+		// fields set) round-trips identically to before these fields existed.
+		if g.FormatVersion != 0 {
+			gg["format_version"] = int(g.FormatVersion)
+		}
+		if g.MessageType != "" {
+			gg["message_type"] = g.MessageType
+		}
+		if g.TimestampFormat != "" {
+			gg["timestamp_format"] = g.TimestampFormat
+		}
+		if g.ResponseCondition != "" {
+			gg["response_condition"] = g.ResponseCondition
+		}
+
+		for k, v := range gg {
+			if v == "" {
+				delete(gg, k)
+			}
+		}
+
+		gl = append(gl, gg)
+	}
+
+	return gl
+}
+
+// resourceServiceV1CustomizeDiff catches logging blocks that reference a
+// response_condition Fastly will reject at apply time: either the name
+// doesn't match any declared condition, or it matches one of the wrong type.
+func resourceServiceV1CustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	conditions := map[string]string{}
+	if v, ok := d.GetOk("condition"); ok {
+		for _, cRaw := range v.(*schema.Set).List() {
+			cf := cRaw.(map[string]interface{})
+			conditions[cf["name"].(string)] = cf["type"].(string)
+		}
+	}
+
+	if v, ok := d.GetOk("gcslogging"); ok {
+		for _, gRaw := range v.(*schema.Set).List() {
+			gf := gRaw.(map[string]interface{})
+			if err := checkConditionType(conditions, "response_condition", gf["response_condition"].(string), "RESPONSE"); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, e := range logEndpoints {
+		v, ok := d.GetOk(e.Name())
+		if !ok {
+			continue
+		}
+		for _, rRaw := range v.(*schema.Set).List() {
+			rf := rRaw.(map[string]interface{})
+			rc, ok := rf["response_condition"]
+			if !ok {
+				continue
+			}
+			if err := checkConditionType(conditions, "response_condition", rc.(string), "RESPONSE"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("cache_setting"); ok {
+		for _, cRaw := range v.(*schema.Set).List() {
+			cf := cRaw.(map[string]interface{})
+			if err := checkConditionType(conditions, "cache_condition", cf["cache_condition"].(string), "CACHE"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("response_object"); ok {
+		for _, rRaw := range v.(*schema.Set).List() {
+			rf := rRaw.(map[string]interface{})
+			if err := checkConditionType(conditions, "request_condition", rf["request_condition"].(string), "REQUEST"); err != nil {
+				return err
+			}
+			if err := checkConditionType(conditions, "cache_condition", rf["cache_condition"].(string), "CACHE"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("vcl"); ok {
+		var mainCount int
+		for _, vRaw := range v.(*schema.Set).List() {
+			vf := vRaw.(map[string]interface{})
+			if vf["main"].(bool) {
+				mainCount++
+			}
+		}
+		if mainCount != 1 {
+			return fmt.Errorf("exactly one vcl block must set main = true, got %d", mainCount)
+		}
+	}
+
+	return nil
+}
+
+// checkConditionType validates that a *_condition attribute (response_condition,
+// cache_condition, request_condition, ...) either is unset or names a
+// condition declared on the service with the expected type.
+func checkConditionType(conditions map[string]string, field, name, wantType string) error {
+	if name == "" {
+		return nil
+	}
+
+	t, found := conditions[name]
+	if !found {
+		return fmt.Errorf("%s %q does not match any condition defined on this service", field, name)
+	}
+	if t != wantType {
+		return fmt.Errorf("%s %q must reference a condition of type %s, got %s", field, name, wantType, t)
+	}
+
+	return nil
+}
+
+// validateConditionReference is the apply-time counterpart to
+// resourceServiceV1CustomizeDiff, used where we already have a
+// *schema.ResourceData in hand (e.g. while building a Create/Update input).
+func validateConditionReference(d *schema.ResourceData, name, wantType string) error {
+	if name == "" {
+		return nil
+	}
+
+	for _, cRaw := range d.Get("condition").(*schema.Set).List() {
+		cf := cRaw.(map[string]interface{})
+		if cf["name"].(string) == name {
+			if cf["type"].(string) != wantType {
+				return fmt.Errorf("Fastly condition %q is type %s, expected %s", name, cf["type"].(string), wantType)
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Fastly condition %q not found on this service", name)
+}
@@ -0,0 +1,127 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func cacheSettingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Unique name to refer to this cache setting",
+				},
+				"action": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "One of pass, restart, deliver",
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						var found bool
+						for _, a := range []string{"pass", "restart", "deliver"} {
+							if v.(string) == a {
+								found = true
+							}
+						}
+						if !found {
+							errors = append(errors, fmt.Errorf("Bad value for action (%s): must be one of pass, restart, deliver", v.(string)))
+						}
+						return
+					},
+				},
+				"cache_condition": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of a CACHE type condition that applies this cache setting",
+				},
+				"stale_ttl": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     0,
+					Description: "Seconds to serve a stale object while revalidating in the background",
+				},
+				"ttl": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Seconds to cache the object. Omit to inherit the backend TTL",
+				},
+			},
+		},
+	}
+}
+
+func processCacheSettings(d *schema.ResourceData, conn *gofastly.Client, version int) error {
+	oc, nc := d.GetChange("cache_setting")
+	if oc == nil {
+		oc = new(schema.Set)
+	}
+	if nc == nil {
+		nc = new(schema.Set)
+	}
+
+	ocs := oc.(*schema.Set)
+	ncs := nc.(*schema.Set)
+	remove := ocs.Difference(ncs).List()
+	add := ncs.Difference(ocs).List()
+
+	for _, cRaw := range remove {
+		cf := cRaw.(map[string]interface{})
+		if err := conn.DeleteCacheSetting(&gofastly.DeleteCacheSettingInput{
+			Service: d.Id(),
+			Version: version,
+			Name:    cf["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, cRaw := range add {
+		cf := cRaw.(map[string]interface{})
+
+		if err := validateConditionReference(d, cf["cache_condition"].(string), "CACHE"); err != nil {
+			return err
+		}
+
+		opts := gofastly.CreateCacheSettingInput{
+			Service:        d.Id(),
+			Version:        version,
+			Name:           cf["name"].(string),
+			Action:         gofastly.CacheSettingAction(cf["action"].(string)),
+			CacheCondition: cf["cache_condition"].(string),
+			StaleTTL:       uint(cf["stale_ttl"].(int)),
+			TTL:            uint(cf["ttl"].(int)),
+		}
+
+		log.Printf("[DEBUG] Create Cache Setting Opts: %#v", opts)
+		if _, err := conn.CreateCacheSetting(&opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenCacheSettings(csList []*gofastly.CacheSetting) []map[string]interface{} {
+	var csl []map[string]interface{}
+	for _, cs := range csList {
+		cm := map[string]interface{}{
+			"name":      cs.Name,
+			"action":    string(cs.Action),
+			"stale_ttl": int(cs.StaleTTL),
+			"ttl":       int(cs.TTL),
+		}
+		if cs.CacheCondition != "" {
+			cm["cache_condition"] = cs.CacheCondition
+		}
+		csl = append(csl, cm)
+	}
+
+	return csl
+}
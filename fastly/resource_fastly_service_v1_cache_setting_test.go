@@ -0,0 +1,183 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func TestResourceFastlyFlattenCacheSetting(t *testing.T) {
+	cases := []struct {
+		remote []*gofastly.CacheSetting
+		local  []map[string]interface{}
+	}{
+		{
+			remote: []*gofastly.CacheSetting{
+				&gofastly.CacheSetting{
+					Name:           "cache setting",
+					Action:         gofastly.CacheSettingAction("deliver"),
+					CacheCondition: "cache_condition_test",
+					StaleTTL:       300,
+					TTL:            3600,
+				},
+			},
+			local: []map[string]interface{}{
+				map[string]interface{}{
+					"name":            "cache setting",
+					"action":          "deliver",
+					"cache_condition": "cache_condition_test",
+					"stale_ttl":       300,
+					"ttl":             3600,
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := flattenCacheSettings(c.remote)
+		if !reflect.DeepEqual(out, c.local) {
+			t.Fatalf("Error matching:\nexpected: %#v\ngot: %#v", c.local, out)
+		}
+	}
+}
+
+func TestAccFastlyServiceV1_cache_setting_pass(t *testing.T) {
+	testAccFastlyServiceV1CacheSettingAction(t, "pass")
+}
+
+func TestAccFastlyServiceV1_cache_setting_restart(t *testing.T) {
+	testAccFastlyServiceV1CacheSettingAction(t, "restart")
+}
+
+func TestAccFastlyServiceV1_cache_setting_deliver(t *testing.T) {
+	testAccFastlyServiceV1CacheSettingAction(t, "deliver")
+}
+
+func testAccFastlyServiceV1CacheSettingAction(t *testing.T, action string) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	csName := fmt.Sprintf("cache-setting-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_cache_setting(name, csName, action),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1Attributes_cache_setting(&service, csName, action),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFastlyServiceV1_cache_setting_stale(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	csName := fmt.Sprintf("cache-setting-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_cache_setting_stale(name, csName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckSetElemAttr("fastly_service_v1.foo", "cache_setting", "stale_ttl", "300"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceV1Attributes_cache_setting(service *gofastly.ServiceDetail, csName, action string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		list, err := conn.ListCacheSettings(&gofastly.ListCacheSettingsInput{
+			Service: service.ID,
+			Version: service.ActiveVersion.Number,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Cache Settings for (%s), version (%v): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if len(list) != 1 {
+			return fmt.Errorf("Cache Setting missing, expected: 1, got: %d", len(list))
+		}
+
+		if list[0].Name != csName {
+			return fmt.Errorf("Cache Setting name mismatch, expected: %s, got: %#v", csName, list[0].Name)
+		}
+
+		if string(list[0].Action) != action {
+			return fmt.Errorf("Cache Setting action mismatch, expected: %s, got: %#v", action, list[0].Action)
+		}
+
+		return nil
+	}
+}
+
+func testAccServiceV1Config_cache_setting(name, csName, action string) string {
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf -test backend"
+  }
+
+  cache_setting {
+    name   = "%s"
+    action = "%s"
+  }
+
+  force_destroy = true
+}`, name, domainName, backendName, csName, action)
+}
+
+func testAccServiceV1Config_cache_setting_stale(name, csName string) string {
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf -test backend"
+  }
+
+  cache_setting {
+    name      = "%s"
+    stale_ttl = 300
+    ttl       = 3600
+  }
+
+  force_destroy = true
+}`, name, domainName, backendName, csName)
+}
@@ -0,0 +1,177 @@
+package fastly
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func init() {
+	registerLogEndpoint(&ftpLogEndpoint{})
+}
+
+type ftpLogEndpoint struct{}
+
+func (e *ftpLogEndpoint) Name() string {
+	return "ftp"
+}
+
+func (e *ftpLogEndpoint) Schema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name to refer to this logging setup",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Hostname of the FTP server",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     21,
+				Description: "Port of the FTP server",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Username to authenticate with",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: envDefault("FASTLY_FTP_PASSWORD"),
+				Description: "Password to authenticate with",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to store the files. Must end with a trailing slash",
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			"gzip_level": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "%h %l %u %t %r %>s",
+				Description: "Apache style log formatting",
+			},
+			"format_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if value := v.(int); value != 1 && value != 2 {
+						errors = append(errors, fmt.Errorf("%q must be either 1 or 2", k))
+					}
+					return
+				},
+			},
+			"message_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "classic",
+			},
+			"timestamp_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "%Y-%m-%dT%H:%M:%S.000",
+			},
+			"response_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a condition to apply this logging",
+			},
+		},
+	}
+}
+
+func (e *ftpLogEndpoint) List(conn *gofastly.Client, serviceID string, version int) (interface{}, error) {
+	remote, err := conn.ListFTPs(&gofastly.ListFTPsInput{
+		Service: serviceID,
+		Version: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up FTP endpoints for (%s), version (%v): %s", serviceID, version, err)
+	}
+	return remote, nil
+}
+
+func (e *ftpLogEndpoint) Flatten(remote interface{}) []map[string]interface{} {
+	var fl []map[string]interface{}
+	for _, f := range remote.([]*gofastly.FTP) {
+		fm := map[string]interface{}{
+			"name":             f.Name,
+			"address":          f.Address,
+			"port":             int(f.Port),
+			"username":         f.Username,
+			"password":         f.Password,
+			"path":             f.Path,
+			"period":           int(f.Period),
+			"gzip_level":       int(f.GzipLevel),
+			"format":           f.Format,
+			"format_version":   int(f.FormatVersion),
+			"message_type":     f.MessageType,
+			"timestamp_format": f.TimestampFormat,
+		}
+		if f.ResponseCondition != "" {
+			fm["response_condition"] = f.ResponseCondition
+		}
+		fl = append(fl, fm)
+	}
+
+	return fl
+}
+
+func (e *ftpLogEndpoint) Diff(conn *gofastly.Client, serviceID string, version int, old, new *schema.Set) error {
+	remove := old.Difference(new).List()
+	add := new.Difference(old).List()
+
+	for _, fRaw := range remove {
+		ff := fRaw.(map[string]interface{})
+		if err := conn.DeleteFTP(&gofastly.DeleteFTPInput{
+			Service: serviceID,
+			Version: version,
+			Name:    ff["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, fRaw := range add {
+		ff := fRaw.(map[string]interface{})
+		if _, err := conn.CreateFTP(&gofastly.CreateFTPInput{
+			Service:           serviceID,
+			Version:           version,
+			Name:              ff["name"].(string),
+			Address:           ff["address"].(string),
+			Port:              uint(ff["port"].(int)),
+			Username:          ff["username"].(string),
+			Password:          ff["password"].(string),
+			Path:              ff["path"].(string),
+			Period:            uint(ff["period"].(int)),
+			GzipLevel:         uint(ff["gzip_level"].(int)),
+			Format:            ff["format"].(string),
+			FormatVersion:     uint(ff["format_version"].(int)),
+			MessageType:       ff["message_type"].(string),
+			TimestampFormat:   ff["timestamp_format"].(string),
+			ResponseCondition: ff["response_condition"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -41,6 +41,40 @@ func TestResourceFastlyFlattenGCS(t *testing.T) {
 				},
 			},
 		},
+		{
+			remote: []*gofastly.GCS{
+				&gofastly.GCS{
+					Name:              "GCS collector",
+					User:              "email@example.com",
+					Bucket:            "bucketName",
+					SecretKey:         "secretKey",
+					Path:              "/logs/",
+					Format:            "log format",
+					FormatVersion:     2,
+					MessageType:       "loggly",
+					TimestampFormat:   "%Y-%m-%dT%H:%M:%S.000",
+					ResponseCondition: "response_condition_test",
+					Period:            3600,
+					GzipLevel:         0,
+				},
+			},
+			local: []map[string]interface{}{
+				map[string]interface{}{
+					"name":               "GCS collector",
+					"email":              "email@example.com",
+					"bucket_name":        "bucketName",
+					"secret_key":         "secretKey",
+					"path":               "/logs/",
+					"format":             "log format",
+					"format_version":     2,
+					"message_type":       "loggly",
+					"timestamp_format":   "%Y-%m-%dT%H:%M:%S.000",
+					"response_condition": "response_condition_test",
+					"period":             3600,
+					"gzip_level":         0,
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -72,6 +106,74 @@ func TestAccFastlyServiceV1_gcslogging(t *testing.T) {
 	})
 }
 
+func TestAccFastlyServiceV1_gcslogging_condition(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	gcsName := fmt.Sprintf("gcs %s", acctest.RandString(10))
+	conditionName := fmt.Sprintf("condition %s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_gcs_condition(name, gcsName, conditionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1Attributes_gcs(&service, name, gcsName),
+					testAccCheckSetElemAttr("fastly_service_v1.foo", "gcslogging", "response_condition", conditionName),
+				),
+			},
+			{
+				// a second plan/apply on the same config should be a no-op
+				Config: testAccServiceV1Config_gcs_condition(name, gcsName, conditionName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1Attributes_gcs(&service, name, gcsName),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceV1Config_gcs_condition(name, gcsName, conditionName string) string {
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf -test backend"
+  }
+
+  condition {
+    name      = "%s"
+    type      = "RESPONSE"
+    statement = "resp.status == 200"
+  }
+
+  gcslogging {
+    name                = "%s"
+    email               = "email@example.com"
+    bucket_name         = "bucketName"
+    secret_key          = "secretKey"
+    format              = "log format"
+    response_condition  = "%s"
+  }
+
+  force_destroy = true
+}`, name, domainName, backendName, conditionName, gcsName, conditionName)
+}
+
 func TestAccFastlyServiceV1_gcslogging_env(t *testing.T) {
 	var service gofastly.ServiceDetail
 	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
@@ -0,0 +1,176 @@
+package fastly
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func init() {
+	registerLogEndpoint(&httpsLogEndpoint{})
+}
+
+type httpsLogEndpoint struct{}
+
+func (e *httpsLogEndpoint) Name() string {
+	return "httpslogging"
+}
+
+func (e *httpsLogEndpoint) Schema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name to refer to this logging setup",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "URL that log data will be sent to",
+			},
+			"method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "POST",
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"header_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"header_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_ca_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_client_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: envDefault("FASTLY_HTTPS_TLS_CLIENT_KEY"),
+			},
+			"tls_hostname": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"message_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "classic",
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "%h %l %u %t %r %>s",
+				Description: "Apache style log formatting",
+			},
+			"format_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if value := v.(int); value != 1 && value != 2 {
+						errors = append(errors, fmt.Errorf("%q must be either 1 or 2", k))
+					}
+					return
+				},
+			},
+			"response_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a condition to apply this logging",
+			},
+		},
+	}
+}
+
+func (e *httpsLogEndpoint) List(conn *gofastly.Client, serviceID string, version int) (interface{}, error) {
+	remote, err := conn.ListHTTPSs(&gofastly.ListHTTPSsInput{
+		Service: serviceID,
+		Version: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up HTTPS endpoints for (%s), version (%v): %s", serviceID, version, err)
+	}
+	return remote, nil
+}
+
+func (e *httpsLogEndpoint) Flatten(remote interface{}) []map[string]interface{} {
+	var hl []map[string]interface{}
+	for _, h := range remote.([]*gofastly.HTTPS) {
+		hm := map[string]interface{}{
+			"name":            h.Name,
+			"url":             h.URL,
+			"method":          h.Method,
+			"content_type":    h.ContentType,
+			"header_name":     h.HeaderName,
+			"header_value":    h.HeaderValue,
+			"tls_ca_cert":     h.TLSCACert,
+			"tls_client_cert": h.TLSClientCert,
+			"tls_client_key":  h.TLSClientKey,
+			"tls_hostname":    h.TLSHostname,
+			"message_type":    h.MessageType,
+			"format":          h.Format,
+			"format_version":  int(h.FormatVersion),
+		}
+		if h.ResponseCondition != "" {
+			hm["response_condition"] = h.ResponseCondition
+		}
+		hl = append(hl, hm)
+	}
+
+	return hl
+}
+
+func (e *httpsLogEndpoint) Diff(conn *gofastly.Client, serviceID string, version int, old, new *schema.Set) error {
+	remove := old.Difference(new).List()
+	add := new.Difference(old).List()
+
+	for _, hRaw := range remove {
+		hf := hRaw.(map[string]interface{})
+		if err := conn.DeleteHTTPS(&gofastly.DeleteHTTPSInput{
+			Service: serviceID,
+			Version: version,
+			Name:    hf["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, hRaw := range add {
+		hf := hRaw.(map[string]interface{})
+		if _, err := conn.CreateHTTPS(&gofastly.CreateHTTPSInput{
+			Service:           serviceID,
+			Version:           version,
+			Name:              hf["name"].(string),
+			URL:               hf["url"].(string),
+			Method:            hf["method"].(string),
+			ContentType:       hf["content_type"].(string),
+			HeaderName:        hf["header_name"].(string),
+			HeaderValue:       hf["header_value"].(string),
+			TLSCACert:         hf["tls_ca_cert"].(string),
+			TLSClientCert:     hf["tls_client_cert"].(string),
+			TLSClientKey:      hf["tls_client_key"].(string),
+			TLSHostname:       hf["tls_hostname"].(string),
+			MessageType:       hf["message_type"].(string),
+			Format:            hf["format"].(string),
+			FormatVersion:     uint(hf["format_version"].(int)),
+			ResponseCondition: hf["response_condition"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
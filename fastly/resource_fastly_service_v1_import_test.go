@@ -0,0 +1,149 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+// TestAccFastlyServiceV1_import creates a service out-of-band via the
+// go-fastly client - bypassing fastly_service_v1 entirely - imports it,
+// and then confirms a plan against a matching config is a no-op. This is
+// how most adopters first bring an existing Fastly service under
+// Terraform management.
+func TestAccFastlyServiceV1_import(t *testing.T) {
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	gcsName := fmt.Sprintf("gcs %s", acctest.RandString(10))
+
+	var serviceID string
+	config := testAccServiceV1Config_import(name, domainName, backendName, gcsName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					id, err := testAccCreateServiceV1OutOfBand(name, domainName, backendName, gcsName)
+					if err != nil {
+						t.Fatal(err)
+					}
+					serviceID = id
+				},
+				Config:       config,
+				ResourceName: "fastly_service_v1.foo",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return serviceID, nil
+				},
+			},
+			{
+				// The same config against the just-imported state should
+				// produce no changes - that's the real assertion that the
+				// import/read path faithfully captured the out-of-band
+				// service, since ImportStateVerify has nothing prior in the
+				// test's tracked state to diff this service against.
+				Config:             config,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+// testAccCreateServiceV1OutOfBand creates and activates a Fastly service
+// directly against the go-fastly client, with none of the Terraform
+// plumbing involved, so the import step genuinely exercises adopting a
+// service Terraform didn't create. Every value it sets matches the
+// defaults in testAccServiceV1Config_import so the post-import plan is
+// empty.
+func testAccCreateServiceV1OutOfBand(name, domainName, backendName, gcsName string) (string, error) {
+	conn := testAccProvider.Meta().(*FastlyClient).conn
+
+	service, err := conn.CreateService(&gofastly.CreateServiceInput{
+		Name: name,
+	})
+	if err != nil {
+		return "", fmt.Errorf("[ERR] Error creating service: %s", err)
+	}
+
+	version, err := conn.CreateVersion(&gofastly.CreateVersionInput{
+		Service: service.ID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("[ERR] Error creating version: %s", err)
+	}
+
+	if _, err := conn.CreateDomain(&gofastly.CreateDomainInput{
+		Service: service.ID,
+		Version: version.Number,
+		Name:    domainName,
+	}); err != nil {
+		return "", fmt.Errorf("[ERR] Error creating domain: %s", err)
+	}
+
+	if _, err := conn.CreateBackend(&gofastly.CreateBackendInput{
+		Service: service.ID,
+		Version: version.Number,
+		Name:    "tf -test backend",
+		Address: backendName,
+		Port:    80,
+	}); err != nil {
+		return "", fmt.Errorf("[ERR] Error creating backend: %s", err)
+	}
+
+	if _, err := conn.CreateGCS(&gofastly.CreateGCSInput{
+		Service:       service.ID,
+		Version:       version.Number,
+		Name:          gcsName,
+		User:          "email@example.com",
+		Bucket:        "bucket",
+		Path:          "/",
+		Period:        3600,
+		Format:        "%h %l %u %t %r %>s",
+		FormatVersion: 1,
+		MessageType:   "classic",
+	}); err != nil {
+		return "", fmt.Errorf("[ERR] Error creating GCS: %s", err)
+	}
+
+	if _, err := conn.ActivateVersion(&gofastly.ActivateVersionInput{
+		Service: service.ID,
+		Version: version.Number,
+	}); err != nil {
+		return "", fmt.Errorf("[ERR] Error activating version: %s", err)
+	}
+
+	return service.ID, nil
+}
+
+func testAccServiceV1Config_import(name, domainName, backendName, gcsName string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name = "%s"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf -test backend"
+  }
+
+  gcslogging {
+    name        = "%s"
+    email       = "email@example.com"
+    bucket_name = "bucket"
+    path        = "/"
+  }
+
+  force_destroy = true
+}`, name, domainName, backendName, gcsName)
+}
@@ -0,0 +1,171 @@
+package fastly
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func init() {
+	registerLogEndpoint(&kafkaLogEndpoint{})
+}
+
+type kafkaLogEndpoint struct{}
+
+func (e *kafkaLogEndpoint) Name() string {
+	return "logging_kafka"
+}
+
+func (e *kafkaLogEndpoint) Schema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name to refer to this logging setup",
+			},
+			"topic": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Kafka topic to send logs to",
+			},
+			"brokers": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A comma-separated list of IP addresses or hostnames of Kafka brokers",
+			},
+			"compression_codec": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					var found bool
+					for _, c := range []string{"", "gzip", "snappy", "lz4"} {
+						if v.(string) == c {
+							found = true
+						}
+					}
+					if !found {
+						errors = append(errors, fmt.Errorf("Bad value for compression_codec (%s): must be one of gzip, snappy, lz4", v.(string)))
+					}
+					return
+				},
+			},
+			"required_acks": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1",
+			},
+			"use_tls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tls_ca_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_client_cert": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tls_client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: envDefault("FASTLY_KAFKA_TLS_CLIENT_KEY"),
+			},
+			"tls_hostname": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "%h %l %u %t %r %>s",
+				Description: "Apache style log formatting",
+			},
+			"response_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a condition to apply this logging",
+			},
+		},
+	}
+}
+
+func (e *kafkaLogEndpoint) List(conn *gofastly.Client, serviceID string, version int) (interface{}, error) {
+	remote, err := conn.ListKafkas(&gofastly.ListKafkasInput{
+		Service: serviceID,
+		Version: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up Kafka endpoints for (%s), version (%v): %s", serviceID, version, err)
+	}
+	return remote, nil
+}
+
+func (e *kafkaLogEndpoint) Flatten(remote interface{}) []map[string]interface{} {
+	var kl []map[string]interface{}
+	for _, k := range remote.([]*gofastly.Kafka) {
+		km := map[string]interface{}{
+			"name":              k.Name,
+			"topic":             k.Topic,
+			"brokers":           k.Brokers,
+			"compression_codec": k.CompressionCodec,
+			"required_acks":     k.RequiredACKs,
+			"use_tls":           k.UseTLS,
+			"tls_ca_cert":       k.TLSCACert,
+			"tls_client_cert":   k.TLSClientCert,
+			"tls_client_key":    k.TLSClientKey,
+			"tls_hostname":      k.TLSHostname,
+			"format":            k.Format,
+		}
+		if k.ResponseCondition != "" {
+			km["response_condition"] = k.ResponseCondition
+		}
+		kl = append(kl, km)
+	}
+
+	return kl
+}
+
+func (e *kafkaLogEndpoint) Diff(conn *gofastly.Client, serviceID string, version int, old, new *schema.Set) error {
+	remove := old.Difference(new).List()
+	add := new.Difference(old).List()
+
+	for _, kRaw := range remove {
+		kf := kRaw.(map[string]interface{})
+		if err := conn.DeleteKafka(&gofastly.DeleteKafkaInput{
+			Service: serviceID,
+			Version: version,
+			Name:    kf["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, kRaw := range add {
+		kf := kRaw.(map[string]interface{})
+		if _, err := conn.CreateKafka(&gofastly.CreateKafkaInput{
+			Service:           serviceID,
+			Version:           version,
+			Name:              kf["name"].(string),
+			Topic:             kf["topic"].(string),
+			Brokers:           kf["brokers"].(string),
+			CompressionCodec:  kf["compression_codec"].(string),
+			RequiredACKs:      kf["required_acks"].(string),
+			UseTLS:            kf["use_tls"].(bool),
+			TLSCACert:         kf["tls_ca_cert"].(string),
+			TLSClientCert:     kf["tls_client_cert"].(string),
+			TLSClientKey:      kf["tls_client_key"].(string),
+			TLSHostname:       kf["tls_hostname"].(string),
+			Format:            kf["format"].(string),
+			ResponseCondition: kf["response_condition"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func TestResourceFastlyFlattenKafka(t *testing.T) {
+	e := &kafkaLogEndpoint{}
+
+	cases := []struct {
+		remote []*gofastly.Kafka
+		local  []map[string]interface{}
+	}{
+		{
+			remote: []*gofastly.Kafka{
+				&gofastly.Kafka{
+					Name:             "kafka collector",
+					Topic:            "logs",
+					Brokers:          "127.0.0.1,127.0.0.2",
+					CompressionCodec: "gzip",
+					RequiredACKs:     "1",
+					UseTLS:           true,
+					TLSCACert:        "ca cert",
+					Format:           "log format",
+				},
+			},
+			local: []map[string]interface{}{
+				map[string]interface{}{
+					"name":              "kafka collector",
+					"topic":             "logs",
+					"brokers":           "127.0.0.1,127.0.0.2",
+					"compression_codec": "gzip",
+					"required_acks":     "1",
+					"use_tls":           true,
+					"tls_ca_cert":       "ca cert",
+					"tls_client_cert":   "",
+					"tls_client_key":    "",
+					"tls_hostname":      "",
+					"format":            "log format",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := e.Flatten(c.remote)
+		if !reflect.DeepEqual(out, c.local) {
+			t.Fatalf("Error matching:\nexpected: %#v\ngot: %#v", c.local, out)
+		}
+	}
+}
+
+func TestAccFastlyServiceV1_kafka(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	kafkaName := fmt.Sprintf("kafka %s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_kafka(name, kafkaName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1Attributes_kafka(&service, name, kafkaName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceV1Attributes_kafka(service *gofastly.ServiceDetail, name, kafkaName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if service.Name != name {
+			return fmt.Errorf("Bad name, expected (%s), got (%s)", name, service.Name)
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		list, err := conn.ListKafkas(&gofastly.ListKafkasInput{
+			Service: service.ID,
+			Version: service.ActiveVersion.Number,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Kafka for (%s), version (%v): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if len(list) != 1 {
+			return fmt.Errorf("Kafka endpoint missing, expected: 1, got: %d", len(list))
+		}
+
+		if list[0].Name != kafkaName {
+			return fmt.Errorf("Kafka name mismatch, expected: %s, got: %#v", kafkaName, list[0].Name)
+		}
+
+		return nil
+	}
+}
+
+func testAccServiceV1Config_kafka(name, kafkaName string) string {
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf -test backend"
+  }
+
+  logging_kafka {
+    name    = "%s"
+    topic   = "logs"
+    brokers = "127.0.0.1,127.0.0.2"
+  }
+
+  force_destroy = true
+}`, name, domainName, backendName, kafkaName)
+}
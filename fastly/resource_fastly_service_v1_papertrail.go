@@ -0,0 +1,126 @@
+package fastly
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func init() {
+	registerLogEndpoint(&papertrailLogEndpoint{})
+}
+
+type papertrailLogEndpoint struct{}
+
+func (e *papertrailLogEndpoint) Name() string {
+	return "papertrail"
+}
+
+func (e *papertrailLogEndpoint) Schema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name to refer to this logging setup",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Hostname of the Papertrail endpoint",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Port of the Papertrail endpoint",
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "%h %l %u %t %r %>s",
+				Description: "Apache style log formatting",
+			},
+			"format_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if value := v.(int); value != 1 && value != 2 {
+						errors = append(errors, fmt.Errorf("%q must be either 1 or 2", k))
+					}
+					return
+				},
+			},
+			"response_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a condition to apply this logging",
+			},
+		},
+	}
+}
+
+func (e *papertrailLogEndpoint) List(conn *gofastly.Client, serviceID string, version int) (interface{}, error) {
+	remote, err := conn.ListPapertrails(&gofastly.ListPapertrailsInput{
+		Service: serviceID,
+		Version: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up Papertrail endpoints for (%s), version (%v): %s", serviceID, version, err)
+	}
+	return remote, nil
+}
+
+func (e *papertrailLogEndpoint) Flatten(remote interface{}) []map[string]interface{} {
+	var pl []map[string]interface{}
+	for _, p := range remote.([]*gofastly.Papertrail) {
+		pm := map[string]interface{}{
+			"name":           p.Name,
+			"address":        p.Address,
+			"port":           int(p.Port),
+			"format":         p.Format,
+			"format_version": int(p.FormatVersion),
+		}
+		if p.ResponseCondition != "" {
+			pm["response_condition"] = p.ResponseCondition
+		}
+		pl = append(pl, pm)
+	}
+
+	return pl
+}
+
+func (e *papertrailLogEndpoint) Diff(conn *gofastly.Client, serviceID string, version int, old, new *schema.Set) error {
+	remove := old.Difference(new).List()
+	add := new.Difference(old).List()
+
+	for _, pRaw := range remove {
+		pf := pRaw.(map[string]interface{})
+		if err := conn.DeletePapertrail(&gofastly.DeletePapertrailInput{
+			Service: serviceID,
+			Version: version,
+			Name:    pf["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, pRaw := range add {
+		pf := pRaw.(map[string]interface{})
+		if _, err := conn.CreatePapertrail(&gofastly.CreatePapertrailInput{
+			Service:           serviceID,
+			Version:           version,
+			Name:              pf["name"].(string),
+			Address:           pf["address"].(string),
+			Port:              uint(pf["port"].(int)),
+			Format:            pf["format"].(string),
+			FormatVersion:     uint(pf["format_version"].(int)),
+			ResponseCondition: pf["response_condition"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
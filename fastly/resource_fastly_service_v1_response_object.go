@@ -0,0 +1,134 @@
+package fastly
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func responseObjectSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Unique name to refer to this response object",
+				},
+				"status": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     200,
+					Description: "The HTTP status code to send",
+				},
+				"response": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "Ok",
+					Description: "The HTTP response description to send",
+				},
+				"content": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The body of the response to send",
+				},
+				"content_type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The MIME type of the content",
+				},
+				"request_condition": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of a REQUEST type condition that applies this response object",
+				},
+				"cache_condition": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of a CACHE type condition that applies this response object",
+				},
+			},
+		},
+	}
+}
+
+func processResponseObjects(d *schema.ResourceData, conn *gofastly.Client, version int) error {
+	or, nr := d.GetChange("response_object")
+	if or == nil {
+		or = new(schema.Set)
+	}
+	if nr == nil {
+		nr = new(schema.Set)
+	}
+
+	ors := or.(*schema.Set)
+	nrs := nr.(*schema.Set)
+	remove := ors.Difference(nrs).List()
+	add := nrs.Difference(ors).List()
+
+	for _, rRaw := range remove {
+		rf := rRaw.(map[string]interface{})
+		if err := conn.DeleteResponseObject(&gofastly.DeleteResponseObjectInput{
+			Service: d.Id(),
+			Version: version,
+			Name:    rf["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, rRaw := range add {
+		rf := rRaw.(map[string]interface{})
+
+		if err := validateConditionReference(d, rf["request_condition"].(string), "REQUEST"); err != nil {
+			return err
+		}
+		if err := validateConditionReference(d, rf["cache_condition"].(string), "CACHE"); err != nil {
+			return err
+		}
+
+		opts := gofastly.CreateResponseObjectInput{
+			Service:          d.Id(),
+			Version:          version,
+			Name:             rf["name"].(string),
+			Status:           uint(rf["status"].(int)),
+			Response:         rf["response"].(string),
+			Content:          rf["content"].(string),
+			ContentType:      rf["content_type"].(string),
+			RequestCondition: rf["request_condition"].(string),
+			CacheCondition:   rf["cache_condition"].(string),
+		}
+
+		log.Printf("[DEBUG] Create Response Object Opts: %#v", opts)
+		if _, err := conn.CreateResponseObject(&opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenResponseObjects(roList []*gofastly.ResponseObject) []map[string]interface{} {
+	var rol []map[string]interface{}
+	for _, ro := range roList {
+		rom := map[string]interface{}{
+			"name":         ro.Name,
+			"status":       int(ro.Status),
+			"response":     ro.Response,
+			"content":      ro.Content,
+			"content_type": ro.ContentType,
+		}
+		if ro.RequestCondition != "" {
+			rom["request_condition"] = ro.RequestCondition
+		}
+		if ro.CacheCondition != "" {
+			rom["cache_condition"] = ro.CacheCondition
+		}
+		rol = append(rol, rom)
+	}
+
+	return rol
+}
@@ -0,0 +1,147 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func TestResourceFastlyFlattenResponseObject(t *testing.T) {
+	cases := []struct {
+		remote []*gofastly.ResponseObject
+		local  []map[string]interface{}
+	}{
+		{
+			remote: []*gofastly.ResponseObject{
+				&gofastly.ResponseObject{
+					Name:             "response object",
+					Status:           200,
+					Response:         "Ok",
+					Content:          "<html>ok</html>",
+					ContentType:      "text/html",
+					RequestCondition: "request_condition_test",
+					CacheCondition:   "cache_condition_test",
+				},
+			},
+			local: []map[string]interface{}{
+				map[string]interface{}{
+					"name":              "response object",
+					"status":            200,
+					"response":          "Ok",
+					"content":           "<html>ok</html>",
+					"content_type":      "text/html",
+					"request_condition": "request_condition_test",
+					"cache_condition":   "cache_condition_test",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := flattenResponseObjects(c.remote)
+		if !reflect.DeepEqual(out, c.local) {
+			t.Fatalf("Error matching:\nexpected: %#v\ngot: %#v", c.local, out)
+		}
+	}
+}
+
+func TestAccFastlyServiceV1_response_object(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	canaryName := fmt.Sprintf("canary-%s", acctest.RandString(10))
+	maintenanceName := fmt.Sprintf("maintenance-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_response_object(name, canaryName, maintenanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1Attributes_response_object(&service, canaryName, maintenanceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceV1Attributes_response_object(service *gofastly.ServiceDetail, canaryName, maintenanceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		list, err := conn.ListResponseObjects(&gofastly.ListResponseObjectsInput{
+			Service: service.ID,
+			Version: service.ActiveVersion.Number,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Response Objects for (%s), version (%v): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if len(list) != 2 {
+			return fmt.Errorf("Response Objects missing, expected: 2, got: %d", len(list))
+		}
+
+		var foundCanary, foundMaintenance bool
+		for _, ro := range list {
+			if ro.Name == canaryName {
+				foundCanary = true
+			}
+			if ro.Name == maintenanceName {
+				foundMaintenance = true
+			}
+		}
+
+		if !foundCanary {
+			return fmt.Errorf("Response Object %s not found", canaryName)
+		}
+		if !foundMaintenance {
+			return fmt.Errorf("Response Object %s not found", maintenanceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccServiceV1Config_response_object(name, canaryName, maintenanceName string) string {
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf -test backend"
+  }
+
+  response_object {
+    name         = "%s"
+    status       = 200
+    response     = "Ok"
+    content      = "<html>canary</html>"
+    content_type = "text/html"
+  }
+
+  response_object {
+    name         = "%s"
+    status       = 404
+    response     = "Not Found"
+    content      = "reason,detail\nmaintenance,down for maintenance"
+    content_type = "text/csv"
+  }
+
+  force_destroy = true
+}`, name, domainName, backendName, canaryName, maintenanceName)
+}
@@ -0,0 +1,126 @@
+package fastly
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func init() {
+	registerLogEndpoint(&sumologicLogEndpoint{})
+}
+
+type sumologicLogEndpoint struct{}
+
+func (e *sumologicLogEndpoint) Name() string {
+	return "sumologic"
+}
+
+func (e *sumologicLogEndpoint) Schema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name to refer to this logging setup",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL to POST to",
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "%h %l %u %t %r %>s",
+				Description: "Apache style log formatting",
+			},
+			"format_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if value := v.(int); value != 1 && value != 2 {
+						errors = append(errors, fmt.Errorf("%q must be either 1 or 2", k))
+					}
+					return
+				},
+			},
+			"message_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "classic",
+			},
+			"response_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a condition to apply this logging",
+			},
+		},
+	}
+}
+
+func (e *sumologicLogEndpoint) List(conn *gofastly.Client, serviceID string, version int) (interface{}, error) {
+	remote, err := conn.ListSumologics(&gofastly.ListSumologicsInput{
+		Service: serviceID,
+		Version: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up Sumologic endpoints for (%s), version (%v): %s", serviceID, version, err)
+	}
+	return remote, nil
+}
+
+func (e *sumologicLogEndpoint) Flatten(remote interface{}) []map[string]interface{} {
+	var sl []map[string]interface{}
+	for _, s := range remote.([]*gofastly.Sumologic) {
+		sm := map[string]interface{}{
+			"name":           s.Name,
+			"url":            s.URL,
+			"format":         s.Format,
+			"format_version": int(s.FormatVersion),
+			"message_type":   s.MessageType,
+		}
+		if s.ResponseCondition != "" {
+			sm["response_condition"] = s.ResponseCondition
+		}
+		sl = append(sl, sm)
+	}
+
+	return sl
+}
+
+func (e *sumologicLogEndpoint) Diff(conn *gofastly.Client, serviceID string, version int, old, new *schema.Set) error {
+	remove := old.Difference(new).List()
+	add := new.Difference(old).List()
+
+	for _, sRaw := range remove {
+		sf := sRaw.(map[string]interface{})
+		if err := conn.DeleteSumologic(&gofastly.DeleteSumologicInput{
+			Service: serviceID,
+			Version: version,
+			Name:    sf["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, sRaw := range add {
+		sf := sRaw.(map[string]interface{})
+		if _, err := conn.CreateSumologic(&gofastly.CreateSumologicInput{
+			Service:           serviceID,
+			Version:           version,
+			Name:              sf["name"].(string),
+			URL:               sf["url"].(string),
+			Format:            sf["format"].(string),
+			FormatVersion:     uint(sf["format_version"].(int)),
+			MessageType:       sf["message_type"].(string),
+			ResponseCondition: sf["response_condition"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package fastly
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func init() {
+	registerLogEndpoint(&syslogLogEndpoint{})
+}
+
+type syslogLogEndpoint struct{}
+
+func (e *syslogLogEndpoint) Name() string {
+	return "syslog"
+}
+
+func (e *syslogLogEndpoint) Schema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name to refer to this logging setup",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Hostname used for the syslog endpoint",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     514,
+				Description: "Port the syslog endpoint listens on",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: envDefault("FASTLY_SYSLOG_TOKEN"),
+				Description: "Authentication token forwarded with each message",
+			},
+			"use_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to connect to the syslog endpoint over TLS",
+			},
+			"tls_ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A secure certificate to authenticate the server with",
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "%h %l %u %t %r %>s",
+				Description: "Apache style log formatting",
+			},
+			"format_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if value := v.(int); value != 1 && value != 2 {
+						errors = append(errors, fmt.Errorf("%q must be either 1 or 2", k))
+					}
+					return
+				},
+			},
+			"message_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "classic",
+			},
+			"response_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of a condition to apply this logging",
+			},
+		},
+	}
+}
+
+func (e *syslogLogEndpoint) List(conn *gofastly.Client, serviceID string, version int) (interface{}, error) {
+	remote, err := conn.ListSyslogs(&gofastly.ListSyslogsInput{
+		Service: serviceID,
+		Version: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up Syslog endpoints for (%s), version (%v): %s", serviceID, version, err)
+	}
+	return remote, nil
+}
+
+func (e *syslogLogEndpoint) Flatten(remote interface{}) []map[string]interface{} {
+	var sl []map[string]interface{}
+	for _, s := range remote.([]*gofastly.Syslog) {
+		sm := map[string]interface{}{
+			"name":           s.Name,
+			"address":        s.Address,
+			"port":           int(s.Port),
+			"token":          s.Token,
+			"use_tls":        s.UseTLS,
+			"tls_ca_cert":    s.TLSCACert,
+			"format":         s.Format,
+			"format_version": int(s.FormatVersion),
+			"message_type":   s.MessageType,
+		}
+		if s.ResponseCondition != "" {
+			sm["response_condition"] = s.ResponseCondition
+		}
+		sl = append(sl, sm)
+	}
+
+	return sl
+}
+
+func (e *syslogLogEndpoint) Diff(conn *gofastly.Client, serviceID string, version int, old, new *schema.Set) error {
+	remove := old.Difference(new).List()
+	add := new.Difference(old).List()
+
+	for _, sRaw := range remove {
+		sf := sRaw.(map[string]interface{})
+		if err := conn.DeleteSyslog(&gofastly.DeleteSyslogInput{
+			Service: serviceID,
+			Version: version,
+			Name:    sf["name"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, sRaw := range add {
+		sf := sRaw.(map[string]interface{})
+		if _, err := conn.CreateSyslog(&gofastly.CreateSyslogInput{
+			Service:           serviceID,
+			Version:           version,
+			Name:              sf["name"].(string),
+			Address:           sf["address"].(string),
+			Port:              uint(sf["port"].(int)),
+			Token:             sf["token"].(string),
+			UseTLS:            sf["use_tls"].(bool),
+			TLSCACert:         sf["tls_ca_cert"].(string),
+			Format:            sf["format"].(string),
+			FormatVersion:     uint(sf["format_version"].(int)),
+			MessageType:       sf["message_type"].(string),
+			ResponseCondition: sf["response_condition"].(string),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
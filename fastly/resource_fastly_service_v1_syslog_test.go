@@ -0,0 +1,130 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func TestResourceFastlyFlattenSyslog(t *testing.T) {
+	e := &syslogLogEndpoint{}
+
+	cases := []struct {
+		remote []*gofastly.Syslog
+		local  []map[string]interface{}
+	}{
+		{
+			remote: []*gofastly.Syslog{
+				&gofastly.Syslog{
+					Name:          "syslog collector",
+					Address:       "127.0.0.1",
+					Port:          514,
+					Format:        "log format",
+					FormatVersion: 1,
+					MessageType:   "classic",
+				},
+			},
+			local: []map[string]interface{}{
+				map[string]interface{}{
+					"name":           "syslog collector",
+					"address":        "127.0.0.1",
+					"port":           514,
+					"token":          "",
+					"use_tls":        false,
+					"tls_ca_cert":    "",
+					"format":         "log format",
+					"format_version": 1,
+					"message_type":   "classic",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := e.Flatten(c.remote)
+		if !reflect.DeepEqual(out, c.local) {
+			t.Fatalf("Error matching:\nexpected: %#v\ngot: %#v", c.local, out)
+		}
+	}
+}
+
+func TestAccFastlyServiceV1_syslog(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	syslogName := fmt.Sprintf("syslog %s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_syslog(name, syslogName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1Attributes_syslog(&service, name, syslogName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceV1Attributes_syslog(service *gofastly.ServiceDetail, name, syslogName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if service.Name != name {
+			return fmt.Errorf("Bad name, expected (%s), got (%s)", name, service.Name)
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		list, err := conn.ListSyslogs(&gofastly.ListSyslogsInput{
+			Service: service.ID,
+			Version: service.ActiveVersion.Number,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Syslog for (%s), version (%v): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if len(list) != 1 {
+			return fmt.Errorf("Syslog endpoint missing, expected: 1, got: %d", len(list))
+		}
+
+		if list[0].Name != syslogName {
+			return fmt.Errorf("Syslog name mismatch, expected: %s, got: %#v", syslogName, list[0].Name)
+		}
+
+		return nil
+	}
+}
+
+func testAccServiceV1Config_syslog(name, syslogName string) string {
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf -test backend"
+  }
+
+  syslog {
+    name    = "%s"
+    address = "127.0.0.1"
+    port    = 514
+  }
+
+  force_destroy = true
+}`, name, domainName, backendName, syslogName)
+}
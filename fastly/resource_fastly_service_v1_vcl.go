@@ -0,0 +1,166 @@
+package fastly
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func vclSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Set:      vclHash,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Unique name to refer to this VCL module",
+				},
+				"content": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The custom VCL code to upload",
+					StateFunc: func(v interface{}) string {
+						switch v.(type) {
+						case string:
+							return vclContentHash(v.(string))
+						default:
+							return ""
+						}
+					},
+				},
+				"main": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Whether this is the main VCL that the service should execute first",
+				},
+			},
+		},
+	}
+}
+
+// vclContentHash mirrors the content field's StateFunc so a raw config
+// value can be compared against the hashed value already in state.
+func vclContentHash(content string) string {
+	sum := sha1.Sum([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// vclHash keys the vcl set on name alone. main is mutable without
+// uploading a new VCL, so it must not factor into the set's identity -
+// otherwise flipping main would look like every element was removed and
+// re-added instead of a simple re-activation.
+func vclHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(m["name"].(string))
+}
+
+// processVCLs reconciles the old and new vcl sets, uploading new VCLs,
+// updating the content of any that changed, removing any that were
+// dropped, and activating whichever one is flagged main.
+func processVCLs(d *schema.ResourceData, conn *gofastly.Client, version int) error {
+	ov, nv := d.GetChange("vcl")
+	if ov == nil {
+		ov = new(schema.Set)
+	}
+	if nv == nil {
+		nv = new(schema.Set)
+	}
+
+	ovs := ov.(*schema.Set)
+	nvs := nv.(*schema.Set)
+
+	old := make(map[string]map[string]interface{})
+	for _, vRaw := range ovs.List() {
+		vf := vRaw.(map[string]interface{})
+		old[vf["name"].(string)] = vf
+	}
+
+	var mainName string
+	var mainCount int
+	for _, vRaw := range nvs.List() {
+		vf := vRaw.(map[string]interface{})
+		if vf["main"].(bool) {
+			mainCount++
+			mainName = vf["name"].(string)
+		}
+	}
+	if mainCount != 1 {
+		return fmt.Errorf("[ERR] Exactly one vcl block must set main = true, got %d", mainCount)
+	}
+
+	new := make(map[string]bool)
+	for _, vRaw := range nvs.List() {
+		vf := vRaw.(map[string]interface{})
+		name := vf["name"].(string)
+		new[name] = true
+
+		of, existed := old[name]
+		switch {
+		case !existed:
+			log.Printf("[DEBUG] Uploading VCL %s for service (%s), version (%d)", name, d.Id(), version)
+			if _, err := conn.CreateVCL(&gofastly.CreateVCLInput{
+				Service: d.Id(),
+				Version: version,
+				Name:    name,
+				Content: vf["content"].(string),
+			}); err != nil {
+				return err
+			}
+		case of["content"].(string) != vclContentHash(vf["content"].(string)):
+			log.Printf("[DEBUG] Updating VCL %s for service (%s), version (%d)", name, d.Id(), version)
+			if _, err := conn.UpdateVCL(&gofastly.UpdateVCLInput{
+				Service: d.Id(),
+				Version: version,
+				Name:    name,
+				Content: vf["content"].(string),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name := range old {
+		if new[name] {
+			continue
+		}
+		if err := conn.DeleteVCL(&gofastly.DeleteVCLInput{
+			Service: d.Id(),
+			Version: version,
+			Name:    name,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if _, err := conn.ActivateVCL(&gofastly.ActivateVCLInput{
+		Service: d.Id(),
+		Version: version,
+		Name:    mainName,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenVCLs(vclList []*gofastly.VCL) []map[string]interface{} {
+	var vl []map[string]interface{}
+	for _, v := range vclList {
+		vl = append(vl, map[string]interface{}{
+			"name":    v.Name,
+			"content": v.Content,
+			"main":    v.Main,
+		})
+	}
+
+	return vl
+}
@@ -0,0 +1,213 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	gofastly "github.com/sethvargo/go-fastly"
+)
+
+func TestResourceFastlyFlattenVCL(t *testing.T) {
+	cases := []struct {
+		remote []*gofastly.VCL
+		local  []map[string]interface{}
+	}{
+		{
+			remote: []*gofastly.VCL{
+				&gofastly.VCL{
+					Name:    "someVclName",
+					Content: "sub vcl_recv {\n  set req.backend = default;\n}",
+					Main:    true,
+				},
+			},
+			local: []map[string]interface{}{
+				map[string]interface{}{
+					"name":    "someVclName",
+					"content": "sub vcl_recv {\n  set req.backend = default;\n}",
+					"main":    true,
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := flattenVCLs(c.remote)
+		if !reflect.DeepEqual(out, c.local) {
+			t.Fatalf("Error matching:\nexpected: %#v\ngot: %#v", c.local, out)
+		}
+	}
+}
+
+func TestAccFastlyServiceV1_vcl_flip_main(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_vcl(name, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					resource.TestCheckResourceAttr("fastly_service_v1.foo", "vcl.#", "2"),
+				),
+			},
+			{
+				Config: testAccServiceV1Config_vcl(name, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					resource.TestCheckResourceAttr("fastly_service_v1.foo", "vcl.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFastlyServiceV1_vcl_content_change(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_vcl(name, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+				),
+			},
+			{
+				Config: testAccServiceV1Config_vcl_content_change(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1VCLContent(&service, "two", "sub vcl_recv {\n  set req.http.X-Changed = \"yes\";\n}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFastlyServiceV1_vcl_import(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_vcl(name, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+				),
+			},
+			{
+				ResourceName:            "fastly_service_v1.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceV1VCLContent(service *gofastly.ServiceDetail, name, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		list, err := conn.ListVCLs(&gofastly.ListVCLsInput{
+			Service: service.ID,
+			Version: service.ActiveVersion.Number,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up VCLs for (%s), version (%v): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		for _, v := range list {
+			if v.Name == name {
+				if v.Content != content {
+					return fmt.Errorf("VCL %s content mismatch, expected: %s, got: %s", name, content, v.Content)
+				}
+				return nil
+			}
+		}
+
+		return fmt.Errorf("VCL %s not found", name)
+	}
+}
+
+func testAccServiceV1Config_vcl(name, mainContent string) string {
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf -test backend"
+  }
+
+  vcl {
+    name    = "one"
+    content = "sub vcl_recv {\n  set req.http.X-Which = \"%s\";\n}"
+    main    = %t
+  }
+
+  vcl {
+    name    = "two"
+    content = "sub vcl_recv {\n  set req.http.X-Which = \"%s\";\n}"
+    main    = %t
+  }
+
+  force_destroy = true
+}`, name, domainName, backendName, mainContent, mainContent == "one", mainContent, mainContent == "two")
+}
+
+func testAccServiceV1Config_vcl_content_change(name string) string {
+	backendName := fmt.Sprintf("%s.aws.amazon.com", acctest.RandString(3))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "%s"
+    name    = "tf -test backend"
+  }
+
+  vcl {
+    name    = "one"
+    content = "sub vcl_recv {\n  set req.http.X-Which = \"one\";\n}"
+    main    = true
+  }
+
+  vcl {
+    name    = "two"
+    content = "sub vcl_recv {\n  set req.http.X-Changed = \"yes\";\n}"
+    main    = false
+  }
+
+  force_destroy = true
+}`, name, domainName, backendName)
+}